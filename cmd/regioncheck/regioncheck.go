@@ -0,0 +1,355 @@
+// Command regioncheck discovers the canonical list of object-storage
+// regions for providers that don't expose them through a stable API we
+// already depend on, and keeps provider/data.go in sync with what it
+// finds.
+//
+// Run it via `go generate ./...` (see provider/generate.go) to regenerate
+// provider/data.go from scratch. Run it with no flags to scrape fresh
+// values and compare them against the baked-in lists instead, exiting
+// non-zero if a provider's regions have drifted - useful as a CI check
+// so a silent provider change doesn't quietly break scans.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sa7mon/S3Scanner/provider"
+	"github.com/sa7mon/S3Scanner/regiondiff"
+)
+
+const (
+	doAvailabilityURL = "https://docs.digitalocean.com/products/spaces/details/availability/"
+	linodeClustersURL = "https://api.linode.com/v4/object-storage/clusters"
+	dreamhostDomain   = "dream.io"
+)
+
+var (
+	writeFlag  = flag.Bool("write", false, "regenerate the baked-in region file instead of diffing against it")
+	outFlag    = flag.String("out", "provider/data.go", "path to write the generated region file to (used with -write)")
+	formatFlag = flag.String("format", "text", "diff report format: text, json, or sarif")
+	failOnFlag = flag.String("fail-on", "any", "exit non-zero when a provider's diff has: any (added or removed), removed, or none")
+
+	doRegionPattern = regexp.MustCompile(`\b([a-z]{3}\d)\.digitaloceanspaces\.com\b`)
+)
+
+// GetRegionsDO scrapes DigitalOcean's Spaces availability page for the
+// region slugs (e.g. "nyc3") that currently serve object storage.
+func GetRegionsDO() ([]string, error) {
+	body, err := fetch(doAvailabilityURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var regions []string
+	for _, match := range doRegionPattern.FindAllStringSubmatch(string(body), -1) {
+		regions = append(regions, match[1])
+	}
+	return dedupeSorted(regions), nil
+}
+
+type linodeCluster struct {
+	ID string `json:"id"`
+}
+
+type linodeClustersResponse struct {
+	Data []linodeCluster `json:"data"`
+}
+
+// GetRegionsLinode queries Linode's public Object Storage clusters API and
+// returns the cluster IDs (e.g. "us-east-1"), each of which identifies a
+// region that offers the service.
+func GetRegionsLinode() ([]string, error) {
+	body, err := fetch(linodeClustersURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed linodeClustersResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, err
+	}
+
+	regions := make([]string, 0, len(parsed.Data))
+	for _, c := range parsed.Data {
+		regions = append(regions, c.ID)
+	}
+	return dedupeSorted(regions), nil
+}
+
+var dreamhostRegionPattern = regexp.MustCompile(`objects-([a-z0-9-]+)\.dream\.io`)
+
+// GetRegionsDreamhost discovers DreamObjects regions by mining certificate
+// transparency logs for "objects-<region>.dream.io" SAN entries, since
+// DreamHost doesn't publish a region list anywhere else.
+func GetRegionsDreamhost() ([]string, error) {
+	return provider.DiscoverRegionsFromCT(dreamhostDomain, dreamhostRegionPattern)
+}
+
+// gcpRegions is the canonical list of Google Cloud Storage regions,
+// mirroring what gcloud and the Cloud Storage locations docs publish.
+// Unlike DO, Linode and Dreamhost, GCP doesn't expose this list over a
+// stable, unauthenticated endpoint, so it's maintained by hand here and
+// re-baked into provider/data.go via go generate like the others.
+var gcpRegions = []string{
+	"asia-east1", "asia-east2", "asia-northeast1", "asia-northeast2", "asia-northeast3",
+	"asia-south1", "asia-south2", "asia-southeast1", "asia-southeast2",
+	"australia-southeast1", "australia-southeast2",
+	"europe-central2", "europe-north1", "europe-southwest1",
+	"europe-west1", "europe-west2", "europe-west3", "europe-west4",
+	"europe-west6", "europe-west8", "europe-west9",
+	"me-central1", "me-west1",
+	"northamerica-northeast1", "northamerica-northeast2",
+	"southamerica-east1", "southamerica-west1",
+	"us-central1", "us-east1", "us-east4", "us-east5",
+	"us-south1", "us-west1", "us-west2", "us-west3", "us-west4",
+}
+
+// GetRegionsGCP returns the canonical list of Google Cloud Storage regions.
+func GetRegionsGCP() ([]string, error) {
+	return dedupeSorted(gcpRegions), nil
+}
+
+func fetch(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func dedupeSorted(items []string) []string {
+	seen := make(map[string]bool, len(items))
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if seen[item] {
+			continue
+		}
+		seen[item] = true
+		out = append(out, item)
+	}
+	sort.Strings(out)
+	return out
+}
+
+var scrapers = map[string]func() ([]string, error){
+	"RegionsDO":        GetRegionsDO,
+	"RegionsLinode":    GetRegionsLinode,
+	"RegionsDreamhost": GetRegionsDreamhost,
+	"RegionsGCP":       GetRegionsGCP,
+}
+
+var baked = map[string][]string{
+	"RegionsDO":        provider.RegionsDO,
+	"RegionsLinode":    provider.RegionsLinode,
+	"RegionsDreamhost": provider.RegionsDreamhost,
+	"RegionsGCP":       provider.RegionsGCP,
+}
+
+func scrapeAll() (map[string][]string, error) {
+	fresh := make(map[string][]string, len(scrapers))
+	for name, scrape := range scrapers {
+		regions, err := scrape()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		fresh[name] = regions
+	}
+	return fresh, nil
+}
+
+func main() {
+	flag.Parse()
+
+	fresh, err := scrapeAll()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "regioncheck:", err)
+		os.Exit(1)
+	}
+
+	if *writeFlag {
+		if err := writeDataGo(*outFlag, fresh); err != nil {
+			fmt.Fprintln(os.Stderr, "regioncheck:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	diffs := make(map[string]regiondiff.Result, len(fresh))
+	for name, got := range fresh {
+		diffs[name] = regiondiff.Diff(baked[name], got)
+	}
+
+	if err := report(*formatFlag, diffs); err != nil {
+		fmt.Fprintln(os.Stderr, "regioncheck:", err)
+		os.Exit(1)
+	}
+
+	if shouldFail(*failOnFlag, diffs) {
+		os.Exit(1)
+	}
+}
+
+// shouldFail applies -fail-on across every provider's diff: "any" fails on
+// either an addition or a removal, "removed" fails only when a region
+// disappeared (since that's what would silently break scans of buckets
+// that live there), and "none" never fails - it just reports.
+func shouldFail(failOn string, diffs map[string]regiondiff.Result) bool {
+	for _, d := range diffs {
+		switch failOn {
+		case "none":
+			continue
+		case "removed":
+			if len(d.Removed) > 0 {
+				return true
+			}
+		default: // "any"
+			if len(d.Added) > 0 || len(d.Removed) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortedNames(diffs map[string]regiondiff.Result) []string {
+	names := make([]string, 0, len(diffs))
+	for name := range diffs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func report(format string, diffs map[string]regiondiff.Result) error {
+	switch format {
+	case "text":
+		writeText(os.Stdout, diffs)
+		return nil
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(diffs)
+	case "sarif":
+		return writeSARIF(os.Stdout, diffs)
+	default:
+		return fmt.Errorf("unknown -format %q", format)
+	}
+}
+
+func writeText(w io.Writer, diffs map[string]regiondiff.Result) {
+	for _, name := range sortedNames(diffs) {
+		d := diffs[name]
+		if len(d.Added) == 0 && len(d.Removed) == 0 {
+			fmt.Fprintf(w, "%s: no drift (%d regions)\n", name, len(d.Unchanged))
+			continue
+		}
+		fmt.Fprintf(w, "%s: +%d -%d\n", name, len(d.Added), len(d.Removed))
+		for _, r := range d.Added {
+			fmt.Fprintf(w, "  + %s\n", r)
+		}
+		for _, r := range d.Removed {
+			fmt.Fprintf(w, "  - %s\n", r)
+		}
+	}
+}
+
+// sarifLog is a minimal, SARIF-style subset of the format - just enough to
+// plug regioncheck's output into tooling that expects a SARIF file,
+// without implementing the full spec.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name string `json:"name"`
+}
+
+type sarifResult struct {
+	RuleID  string       `json:"ruleId"`
+	Level   string       `json:"level"`
+	Message sarifMessage `json:"message"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+func writeSARIF(w io.Writer, diffs map[string]regiondiff.Result) error {
+	run := sarifRun{Tool: sarifTool{Driver: sarifDriver{Name: "regioncheck"}}}
+
+	for _, name := range sortedNames(diffs) {
+		d := diffs[name]
+		for _, r := range d.Removed {
+			run.Results = append(run.Results, sarifResult{
+				RuleID: "region-removed",
+				Level:  "error",
+				Message: sarifMessage{
+					Text: fmt.Sprintf("%s lost region %q - scans of buckets there will silently stop working", name, r),
+				},
+			})
+		}
+		for _, r := range d.Added {
+			run.Results = append(run.Results, sarifResult{
+				RuleID: "region-added",
+				Level:  "note",
+				Message: sarifMessage{
+					Text: fmt.Sprintf("%s gained region %q", name, r),
+				},
+			})
+		}
+	}
+
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+func writeDataGo(path string, fresh map[string][]string) error {
+	var b strings.Builder
+	b.WriteString("// Code generated by go generate; DO NOT EDIT.\n")
+	b.WriteString("// Regenerate with: go generate ./...\n\n")
+	b.WriteString("package provider\n\n")
+	b.WriteString("// LastGenerated records when this file was last regenerated by regioncheck.\n")
+	fmt.Fprintf(&b, "var LastGenerated = %q\n\n", time.Now().UTC().Format(time.RFC3339))
+
+	for _, name := range []string{"RegionsDO", "RegionsLinode", "RegionsDreamhost", "RegionsGCP"} {
+		fmt.Fprintf(&b, "var %s = []string{\n", name)
+		for _, r := range fresh[name] {
+			fmt.Fprintf(&b, "\t%q,\n", r)
+		}
+		b.WriteString("}\n\n")
+	}
+
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
@@ -1,8 +1,11 @@
 package main
 
 import (
-	"github.com/stretchr/testify/assert"
 	"testing"
+
+	"github.com/sa7mon/S3Scanner/provider"
+	"github.com/sa7mon/S3Scanner/regiondiff"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGetRegionsDO(t *testing.T) {
@@ -10,6 +13,9 @@ func TestGetRegionsDO(t *testing.T) {
 	assert.Nil(t, err)
 	assert.GreaterOrEqual(t, len(r), 1)
 	assert.Contains(t, r, "nyc3")
+
+	diff := regiondiff.Diff(provider.RegionsDO, r)
+	assert.Contains(t, diff.Unchanged, "nyc3")
 }
 
 func TestGetRegionsLinode(t *testing.T) {
@@ -17,6 +23,9 @@ func TestGetRegionsLinode(t *testing.T) {
 	assert.Nil(t, err)
 	assert.GreaterOrEqual(t, len(r), 1)
 	assert.Contains(t, r, "us-east-1")
+
+	diff := regiondiff.Diff(provider.RegionsLinode, r)
+	assert.Contains(t, diff.Unchanged, "us-east-1")
 }
 
 func TestGetRegionsDreamhost(t *testing.T) {
@@ -24,4 +33,30 @@ func TestGetRegionsDreamhost(t *testing.T) {
 	assert.Nil(t, err)
 	assert.GreaterOrEqual(t, len(dor), 1)
 	assert.Contains(t, dor, "us-east-1")
-}
\ No newline at end of file
+
+	diff := regiondiff.Diff(provider.RegionsDreamhost, dor)
+	assert.Contains(t, diff.Unchanged, "us-east-1")
+}
+
+func TestGetRegionsGCP(t *testing.T) {
+	r, err := GetRegionsGCP()
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, len(r), 1)
+	assert.Contains(t, r, "us-central1")
+	assert.Contains(t, r, "europe-west1")
+
+	diff := regiondiff.Diff(provider.RegionsGCP, r)
+	assert.Contains(t, diff.Unchanged, "us-central1")
+	assert.Contains(t, diff.Unchanged, "europe-west1")
+}
+
+func TestDiffDORegionsAgainstPreviousSnapshot(t *testing.T) {
+	previous := []string{"nyc1", "ams2", "sfo1"}
+	current := []string{"nyc1", "sfo1", "nyc3"}
+
+	diff := regiondiff.Diff(previous, current)
+	assert.Contains(t, diff.Added, "nyc3")
+	assert.Contains(t, diff.Removed, "ams2")
+	assert.Contains(t, diff.Unchanged, "nyc1")
+	assert.Contains(t, diff.Unchanged, "sfo1")
+}
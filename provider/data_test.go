@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratedRegionsParse(t *testing.T) {
+	assert.NotEmpty(t, LastGenerated)
+	assert.GreaterOrEqual(t, len(RegionsDO), 1)
+	assert.Contains(t, RegionsDO, "nyc3")
+	assert.GreaterOrEqual(t, len(RegionsLinode), 1)
+	assert.Contains(t, RegionsLinode, "us-east-1")
+	assert.GreaterOrEqual(t, len(RegionsDreamhost), 1)
+	assert.Contains(t, RegionsDreamhost, "us-east-1")
+	assert.GreaterOrEqual(t, len(RegionsGCP), 1)
+	assert.Contains(t, RegionsGCP, "us-central1")
+	assert.Contains(t, RegionsGCP, "europe-west1")
+}
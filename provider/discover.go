@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+)
+
+// ctBaseURL is the crt.sh endpoint queried by DiscoverRegionsFromCT. It's a
+// var so tests can point it at an httptest server.
+var ctBaseURL = "https://crt.sh"
+
+// ctLogEntry is the subset of a crt.sh JSON result we care about. A single
+// certificate can cover several hostnames, so name_value may contain more
+// than one name separated by newlines.
+type ctLogEntry struct {
+	NameValue string `json:"name_value"`
+}
+
+// DiscoverRegionsFromCT discovers region tokens for providers that don't
+// publish a region list anywhere except the hostnames in the certificates
+// they issue. It queries crt.sh's certificate transparency log for every
+// certificate covering *.domain, then applies pattern - which must have
+// exactly one capture group holding the region token - to every SAN entry
+// found, deduplicating the results.
+//
+// This was extracted from the Dreamhost DreamObjects region lookup so that
+// other CT-only providers (Scaleway, Wasabi, self-hosted MinIO, ...) can
+// reuse it by supplying just a domain and a pattern.
+func DiscoverRegionsFromCT(domain string, pattern *regexp.Regexp) ([]string, error) {
+	url := fmt.Sprintf("%s/?q=%%25.%s&output=json", ctBaseURL, domain)
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+
+	var entries []ctLogEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var regions []string
+	for _, entry := range entries {
+		for _, match := range pattern.FindAllStringSubmatch(entry.NameValue, -1) {
+			region := match[1]
+			if seen[region] {
+				continue
+			}
+			seen[region] = true
+			regions = append(regions, region)
+		}
+	}
+	sort.Strings(regions)
+	return regions, nil
+}
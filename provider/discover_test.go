@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const cannedCTResponse = `[
+	{"name_value": "objects-us-east-1.dream.io"},
+	{"name_value": "objects-us-east-1.dream.io"},
+	{"name_value": "*.objects-us-west-1.dream.io"},
+	{"name_value": "objects-eu-west-1.dream.io\nwww.objects-eu-west-1.dream.io"},
+	{"name_value": "unrelated.dream.io"}
+]`
+
+func withCTServer(t *testing.T, body string, fn func()) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	original := ctBaseURL
+	ctBaseURL = srv.URL
+	defer func() { ctBaseURL = original }()
+
+	fn()
+}
+
+func TestDiscoverRegionsFromCT(t *testing.T) {
+	pattern := regexp.MustCompile(`objects-([a-z0-9-]+)\.dream\.io`)
+
+	withCTServer(t, cannedCTResponse, func() {
+		regions, err := DiscoverRegionsFromCT("dream.io", pattern)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"eu-west-1", "us-east-1", "us-west-1"}, regions)
+	})
+}
+
+func TestDiscoverRegionsFromCTEmpty(t *testing.T) {
+	pattern := regexp.MustCompile(`objects-([a-z0-9-]+)\.dream\.io`)
+
+	withCTServer(t, `[]`, func() {
+		regions, err := DiscoverRegionsFromCT("dream.io", pattern)
+		assert.Nil(t, err)
+		assert.Empty(t, regions)
+	})
+}
+
+func TestDiscoverRegionsFromCTUnexpectedStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	original := ctBaseURL
+	ctBaseURL = srv.URL
+	defer func() { ctBaseURL = original }()
+
+	_, err := DiscoverRegionsFromCT("dream.io", regexp.MustCompile(`objects-([a-z0-9-]+)\.dream\.io`))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ProviderGCP talks to Google Cloud Storage's S3-compatible XML API at
+// storage.googleapis.com.
+type ProviderGCP struct{}
+
+// Name returns "gcp".
+func (ProviderGCP) Name() string { return "gcp" }
+
+// BucketURL returns the GCS XML API URL for bucket.
+func (ProviderGCP) BucketURL(bucket string) string {
+	return fmt.Sprintf("https://storage.googleapis.com/%s", bucket)
+}
+
+// CheckACL interprets GCS's anonymous ListObjects response. GCS differs
+// from AWS here: a bucket with uniform bucket-level access enabled
+// refuses to evaluate ACLs at all and returns 400 Bad Request rather than
+// 403 Forbidden, and the ListObjects body never echoes ACL grantees the
+// way an AccessControlPolicy document would - an anonymous request
+// succeeding (200) already means the bucket grants allUsers read, so
+// there's nothing to pattern-match in the body for that.
+func (ProviderGCP) CheckACL(resp *http.Response, _ []byte) (AccessLevel, error) {
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return AccessPublic, nil
+	case http.StatusForbidden, http.StatusBadRequest:
+		return AccessDenied, nil
+	default:
+		var url string
+		if resp.Request != nil && resp.Request.URL != nil {
+			url = resp.Request.URL.String()
+		}
+		return AccessDenied, fmt.Errorf("unexpected status %s from %s", resp.Status, url)
+	}
+}
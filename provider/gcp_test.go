@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderGCPBucketURL(t *testing.T) {
+	assert.Equal(t, "https://storage.googleapis.com/my-bucket", ProviderGCP{}.BucketURL("my-bucket"))
+}
+
+func TestProviderGCPCheckACL(t *testing.T) {
+	p := ProviderGCP{}
+
+	// An anonymous ListObjects that succeeds at all already means the
+	// bucket grants allUsers read - GCS doesn't echo ACL grantees in this
+	// response body, so the body's contents shouldn't matter.
+	level, err := p.CheckACL(&http.Response{StatusCode: http.StatusOK}, []byte(`<ListBucketResult></ListBucketResult>`))
+	assert.Nil(t, err)
+	assert.Equal(t, AccessPublic, level)
+
+	level, err = p.CheckACL(&http.Response{StatusCode: http.StatusForbidden}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, AccessDenied, level)
+
+	// Uniform bucket-level access makes GCS refuse to evaluate ACLs at
+	// all, returning 400 rather than 403.
+	level, err = p.CheckACL(&http.Response{StatusCode: http.StatusBadRequest}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, AccessDenied, level)
+}
+
+func TestProviderGCPCheckACLUnexpectedStatusNoRequest(t *testing.T) {
+	// resp.Request is nil for a synthetically constructed response (as
+	// above), so the error path must not dereference it.
+	p := ProviderGCP{}
+
+	level, err := p.CheckACL(&http.Response{StatusCode: http.StatusInternalServerError}, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, AccessDenied, level)
+}
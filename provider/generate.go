@@ -0,0 +1,3 @@
+package provider
+
+//go:generate go run ../cmd/regioncheck -write -out=data.go
@@ -0,0 +1,35 @@
+// Package provider holds the per-backend logic needed to address and probe
+// buckets across the object-storage services s3scanner supports, plus the
+// region lists (see data.go) and discovery helpers those backends are
+// built from.
+package provider
+
+import "net/http"
+
+// AccessLevel describes what an anonymous caller can do to a bucket.
+type AccessLevel int
+
+const (
+	// AccessDenied means the anonymous ListObjects request was rejected.
+	AccessDenied AccessLevel = iota
+	// AccessAuthUsers means the bucket listed but isn't world-readable.
+	AccessAuthUsers
+	// AccessPublic means the bucket is readable by anyone, including
+	// unauthenticated callers.
+	AccessPublic
+)
+
+// Provider is implemented by each supported object-storage backend, so new
+// backends can be added without touching the core scanner.
+type Provider interface {
+	// Name returns the short, lowercase identifier for the provider (e.g. "gcp").
+	Name() string
+
+	// BucketURL returns the URL used to address bucket on this provider.
+	BucketURL(bucket string) string
+
+	// CheckACL inspects the response (and body) from an anonymous
+	// ListObjects request against BucketURL(bucket) and reports what
+	// access, if any, is open.
+	CheckACL(resp *http.Response, body []byte) (AccessLevel, error)
+}
@@ -0,0 +1,49 @@
+// Package regiondiff compares a provider's previously baked-in region list
+// against a freshly scraped one, so tooling like cmd/regioncheck can report
+// what changed instead of just whether something changed.
+package regiondiff
+
+import "sort"
+
+// Result is the outcome of diffing two region lists.
+type Result struct {
+	Added     []string
+	Removed   []string
+	Unchanged []string
+}
+
+// Diff compares old against new and reports which regions were added,
+// removed, or are present in both. Input order doesn't matter and
+// duplicate entries are collapsed; every field of Result is sorted so the
+// output is stable and easy to diff itself.
+func Diff(old, new []string) Result {
+	oldSet := toSet(old)
+	newSet := toSet(new)
+
+	var result Result
+	for region := range newSet {
+		if oldSet[region] {
+			result.Unchanged = append(result.Unchanged, region)
+		} else {
+			result.Added = append(result.Added, region)
+		}
+	}
+	for region := range oldSet {
+		if !newSet[region] {
+			result.Removed = append(result.Removed, region)
+		}
+	}
+
+	sort.Strings(result.Added)
+	sort.Strings(result.Removed)
+	sort.Strings(result.Unchanged)
+	return result
+}
+
+func toSet(items []string) map[string]bool {
+	set := make(map[string]bool, len(items))
+	for _, item := range items {
+		set[item] = true
+	}
+	return set
+}
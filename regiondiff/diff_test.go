@@ -0,0 +1,44 @@
+package regiondiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffAddedAndRemoved(t *testing.T) {
+	result := Diff([]string{"nyc1", "ams2", "sfo1"}, []string{"nyc1", "sfo1", "nyc3"})
+
+	assert.Equal(t, []string{"nyc3"}, result.Added)
+	assert.Equal(t, []string{"ams2"}, result.Removed)
+	assert.Equal(t, []string{"nyc1", "sfo1"}, result.Unchanged)
+}
+
+func TestDiffOrderingIndependence(t *testing.T) {
+	a := Diff([]string{"c", "a", "b"}, []string{"b", "d", "a"})
+	b := Diff([]string{"a", "b", "c"}, []string{"a", "b", "d"})
+
+	assert.Equal(t, a, b)
+}
+
+func TestDiffDuplicates(t *testing.T) {
+	result := Diff([]string{"a", "a", "b"}, []string{"a", "b", "b", "c"})
+
+	assert.Equal(t, []string{"c"}, result.Added)
+	assert.Empty(t, result.Removed)
+	assert.Equal(t, []string{"a", "b"}, result.Unchanged)
+}
+
+func TestDiffEmptyInputs(t *testing.T) {
+	assert.Equal(t, Result{}, Diff(nil, nil))
+
+	result := Diff(nil, []string{"a"})
+	assert.Equal(t, []string{"a"}, result.Added)
+	assert.Empty(t, result.Removed)
+	assert.Empty(t, result.Unchanged)
+
+	result = Diff([]string{"a"}, nil)
+	assert.Empty(t, result.Added)
+	assert.Equal(t, []string{"a"}, result.Removed)
+	assert.Empty(t, result.Unchanged)
+}